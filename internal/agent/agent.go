@@ -0,0 +1,89 @@
+package agent
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/walterfan/lazy-rabbit-agent/internal/llm"
+)
+
+// DefaultMaxSteps bounds how many model/tool round-trips Agent.Run will
+// perform before giving up, protecting against a model that loops forever on
+// tool calls.
+const DefaultMaxSteps = 8
+
+// Agent drives a chat-completions loop against an llm.Client, dispatching
+// any tool calls the model makes through a ToolRegistry.
+type Agent struct {
+	Client              llm.Client
+	Registry            *ToolRegistry
+	MaxSteps            int
+	FunctionCallingMode llm.FunctionCallingMode
+}
+
+// NewAgent returns an Agent that drives client, dispatching tool calls
+// through registry.
+func NewAgent(client llm.Client, registry *ToolRegistry) *Agent {
+	return &Agent{
+		Client:   client,
+		Registry: registry,
+		MaxSteps: DefaultMaxSteps,
+	}
+}
+
+// Run drives messages through the model until it returns a final assistant
+// message with no tool calls, or MaxSteps round-trips are exhausted. It
+// returns the full conversation, including any tool calls and their results.
+func (a *Agent) Run(ctx context.Context, messages []llm.Message) ([]llm.Message, error) {
+	maxSteps := a.MaxSteps
+	if maxSteps <= 0 {
+		maxSteps = DefaultMaxSteps
+	}
+
+	for step := 0; step < maxSteps; step++ {
+		resp, err := a.Client.Chat(ctx, llm.ChatRequest{
+			Messages:   messages,
+			Tools:      a.Registry.Tools(),
+			ToolChoice: a.FunctionCallingMode.ToolChoice(),
+		})
+		if err != nil {
+			return messages, fmt.Errorf("step %d: %v", step, err)
+		}
+		messages = append(messages, resp.Message)
+
+		if len(resp.Message.ToolCalls) == 0 {
+			return messages, nil
+		}
+
+		messages = append(messages, a.dispatchToolCalls(resp.Message.ToolCalls)...)
+	}
+
+	return messages, fmt.Errorf("exceeded MaxSteps (%d) without a final answer", maxSteps)
+}
+
+// dispatchToolCalls executes every tool call concurrently and returns the
+// resulting "tool" role messages in the same order as toolCalls.
+func (a *Agent) dispatchToolCalls(toolCalls []llm.ToolCall) []llm.Message {
+	results := make([]llm.Message, len(toolCalls))
+
+	var wg sync.WaitGroup
+	for i, tc := range toolCalls {
+		wg.Add(1)
+		go func(i int, tc llm.ToolCall) {
+			defer wg.Done()
+			content, err := a.Registry.Call(tc)
+			if err != nil {
+				content = fmt.Sprintf("error: %v", err)
+			}
+			results[i] = llm.Message{
+				Role:       "tool",
+				ToolCallID: tc.ID,
+				Content:    content,
+			}
+		}(i, tc)
+	}
+	wg.Wait()
+
+	return results
+}
@@ -0,0 +1,73 @@
+package agent
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/walterfan/lazy-rabbit-agent/internal/llm"
+)
+
+func TestAgentRunDispatchesToolCallAndReturnsFinalAnswer(t *testing.T) {
+	step := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		step++
+		w.Header().Set("Content-Type", "application/json")
+		if step == 1 {
+			fmt.Fprint(w, `{"choices":[{"message":{"role":"assistant","tool_calls":[{"id":"call_1","type":"function","function":{"name":"echo","arguments":"{\"text\":\"hi\"}"}}]}}]}`)
+			return
+		}
+		fmt.Fprint(w, `{"choices":[{"message":{"role":"assistant","content":"done"}}]}`)
+	}))
+	defer server.Close()
+
+	registry := NewToolRegistry()
+	registry.Register("echo", map[string]interface{}{"type": "object"}, "echoes its input", func(args json.RawMessage) (string, error) {
+		return string(args), nil
+	})
+
+	client := llm.NewOpenAIClient("test-key", server.URL, "test-model")
+	a := NewAgent(client, registry)
+	messages, err := a.Run(context.Background(), []llm.Message{{Role: "user", Content: "say hi"}})
+	if err != nil {
+		t.Fatalf("Run returned error: %v", err)
+	}
+
+	if got := messages[len(messages)-1].Content; got != "done" {
+		t.Fatalf("expected final message %q, got %q", "done", got)
+	}
+
+	var sawToolResult bool
+	for _, m := range messages {
+		if m.Role == "tool" && m.ToolCallID == "call_1" && m.Content == `{"text":"hi"}` {
+			sawToolResult = true
+		}
+	}
+	if !sawToolResult {
+		t.Fatalf("expected a tool result message for call_1, got %+v", messages)
+	}
+}
+
+func TestAgentRunStopsAtMaxSteps(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{"choices":[{"message":{"role":"assistant","tool_calls":[{"id":"call_1","type":"function","function":{"name":"noop","arguments":"{}"}}]}}]}`)
+	}))
+	defer server.Close()
+
+	registry := NewToolRegistry()
+	registry.Register("noop", map[string]interface{}{"type": "object"}, "does nothing", func(args json.RawMessage) (string, error) {
+		return "", nil
+	})
+
+	client := llm.NewOpenAIClient("test-key", server.URL, "test-model")
+	a := NewAgent(client, registry)
+	a.MaxSteps = 2
+
+	if _, err := a.Run(context.Background(), []llm.Message{{Role: "user", Content: "loop forever"}}); err == nil {
+		t.Fatalf("expected an error when MaxSteps is exceeded")
+	}
+}
@@ -0,0 +1,67 @@
+package agent
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/walterfan/lazy-rabbit-agent/internal/llm"
+)
+
+// ToolHandler executes a tool call and returns its result as a string, or an error.
+type ToolHandler func(args json.RawMessage) (string, error)
+
+// ToolRegistry holds the set of tools an Agent may call, keyed by name.
+type ToolRegistry struct {
+	tools    map[string]llm.Tool
+	handlers map[string]ToolHandler
+}
+
+// NewToolRegistry returns an empty ToolRegistry.
+func NewToolRegistry() *ToolRegistry {
+	return &ToolRegistry{
+		tools:    make(map[string]llm.Tool),
+		handlers: make(map[string]ToolHandler),
+	}
+}
+
+// Register adds a tool definition and its handler under name, overwriting any
+// existing registration for the same name.
+func (r *ToolRegistry) Register(name string, schema map[string]interface{}, description string, handler ToolHandler) {
+	r.tools[name] = llm.Tool{
+		Type: "function",
+		Function: llm.Function{
+			Name:        name,
+			Description: description,
+			Parameters:  schema,
+		},
+	}
+	r.handlers[name] = handler
+}
+
+// Tools returns the tool definitions to send to the model.
+func (r *ToolRegistry) Tools() []llm.Tool {
+	tools := make([]llm.Tool, 0, len(r.tools))
+	for _, t := range r.tools {
+		tools = append(tools, t)
+	}
+	return tools
+}
+
+// Call validates toolCall's arguments against the tool's declared JSON
+// Schema, then invokes the handler registered for toolCall.Function.Name.
+// Validation errors are returned rather than passed to the handler, so the
+// caller can feed them back to the model instead of crashing.
+func (r *ToolRegistry) Call(toolCall llm.ToolCall) (string, error) {
+	handler, ok := r.handlers[toolCall.Function.Name]
+	if !ok {
+		return "", fmt.Errorf("no handler registered for tool %q", toolCall.Function.Name)
+	}
+
+	if tool, ok := r.tools[toolCall.Function.Name]; ok {
+		if err := validateArguments(tool, toolCall); err != nil {
+			return "", err
+		}
+	}
+
+	return handler(json.RawMessage(toolCall.Function.Arguments))
+}
@@ -0,0 +1,66 @@
+package agent
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/walterfan/lazy-rabbit-agent/internal/llm"
+)
+
+// StreamRun drives messages through the model like Run, but prints assistant
+// content tokens to stdout as they arrive instead of waiting for the full
+// response. Tool calls are still collected, dispatched, and fed back to the
+// model once the stream completes, and the loop continues up to MaxSteps.
+// It requires a.Client to implement llm.StreamingClient.
+func (a *Agent) StreamRun(ctx context.Context, messages []llm.Message) ([]llm.Message, error) {
+	streamer, ok := a.Client.(llm.StreamingClient)
+	if !ok {
+		return messages, fmt.Errorf("provider does not support streaming")
+	}
+
+	maxSteps := a.MaxSteps
+	if maxSteps <= 0 {
+		maxSteps = DefaultMaxSteps
+	}
+
+	for step := 0; step < maxSteps; step++ {
+		events, err := streamer.ChatStream(ctx, llm.ChatRequest{
+			Messages:   messages,
+			Tools:      a.Registry.Tools(),
+			ToolChoice: a.FunctionCallingMode.ToolChoice(),
+		})
+		if err != nil {
+			return messages, fmt.Errorf("step %d: %v", step, err)
+		}
+
+		var content strings.Builder
+		var toolCalls []llm.ToolCall
+		for event := range events {
+			switch event.Type {
+			case llm.ContentDelta:
+				fmt.Print(event.Content)
+				content.WriteString(event.Content)
+			case llm.ToolCallDelta:
+				toolCalls = append(toolCalls, event.ToolCall)
+			case llm.Done:
+				if event.Err != nil {
+					return messages, fmt.Errorf("step %d: %v", step, event.Err)
+				}
+			}
+		}
+		if content.Len() > 0 {
+			fmt.Println()
+		}
+
+		messages = append(messages, llm.Message{Role: "assistant", Content: content.String(), ToolCalls: toolCalls})
+
+		if len(toolCalls) == 0 {
+			return messages, nil
+		}
+
+		messages = append(messages, a.dispatchToolCalls(toolCalls)...)
+	}
+
+	return messages, fmt.Errorf("exceeded MaxSteps (%d) without a final answer", maxSteps)
+}
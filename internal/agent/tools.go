@@ -0,0 +1,150 @@
+package agent
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os/exec"
+	"strings"
+	"time"
+
+	"github.com/walterfan/lazy-rabbit-agent/internal/weather"
+)
+
+// weatherArgs is the schema for the get_weather tool's arguments.
+type weatherArgs struct {
+	Location string `json:"location"`
+	Units    string `json:"units,omitempty"` // "metric" or "imperial"; defaults to metric
+	Days     int    `json:"days,omitempty"`  // forecast length; omitted or 0 means current conditions
+}
+
+// RegisterWeatherTool registers the built-in get_weather tool, which looks up
+// current conditions or a forecast from provider.
+func RegisterWeatherTool(r *ToolRegistry, provider weather.Provider) {
+	r.Register("get_weather", map[string]interface{}{
+		"type": "object",
+		"properties": map[string]interface{}{
+			"location": map[string]interface{}{
+				"type":        "string",
+				"description": "The city and state, e.g., 合肥市",
+			},
+			"units": map[string]interface{}{
+				"type":        "string",
+				"enum":        []string{"metric", "imperial"},
+				"description": "Temperature units to report in, defaults to metric",
+			},
+			"days": map[string]interface{}{
+				"type":        "integer",
+				"description": "Number of forecast days to return; omit for current conditions",
+			},
+		},
+		"required": []string{"location"},
+	}, "Get weather of a location. User must supply a location first.", func(args json.RawMessage) (string, error) {
+		var parsed weatherArgs
+		if err := json.Unmarshal(args, &parsed); err != nil {
+			return "", fmt.Errorf("failed to unmarshal tool arguments: %v", err)
+		}
+
+		if parsed.Days > 0 {
+			reports, err := provider.Forecast(context.Background(), parsed.Location, parsed.Days)
+			if err != nil {
+				return "", err
+			}
+			return formatReports(reports, parsed.Units), nil
+		}
+
+		report, err := provider.Current(context.Background(), parsed.Location)
+		if err != nil {
+			return "", err
+		}
+		return formatReports([]weather.Report{*report}, parsed.Units), nil
+	})
+}
+
+// formatReports renders reports as a newline-separated summary, converting
+// temperatures to the requested units (metric by default).
+func formatReports(reports []weather.Report, units string) string {
+	lines := make([]string, 0, len(reports))
+	for _, r := range reports {
+		temp, unit := r.TempC, "°C"
+		if units == "imperial" {
+			temp, unit = r.TempF, "°F"
+		}
+		lines = append(lines, fmt.Sprintf("%s: %s, %.1f%s, at %s", r.Location, r.Conditions, temp, unit, r.Date))
+	}
+	return strings.Join(lines, "\n")
+}
+
+// fetchArgs is the schema for the http_fetch tool's arguments.
+type fetchArgs struct {
+	URL string `json:"url"`
+}
+
+// RegisterFetchTool registers a generic http_fetch tool that GETs a URL and
+// returns its body as text, capped at 64KiB to avoid blowing up the context window.
+func RegisterFetchTool(r *ToolRegistry) {
+	r.Register("http_fetch", map[string]interface{}{
+		"type": "object",
+		"properties": map[string]interface{}{
+			"url": map[string]interface{}{
+				"type":        "string",
+				"description": "The URL to fetch",
+			},
+		},
+		"required": []string{"url"},
+	}, "Fetch the contents of a URL over HTTP GET.", func(args json.RawMessage) (string, error) {
+		var parsed fetchArgs
+		if err := json.Unmarshal(args, &parsed); err != nil {
+			return "", fmt.Errorf("failed to unmarshal tool arguments: %v", err)
+		}
+
+		client := &http.Client{Timeout: 10 * time.Second}
+		resp, err := client.Get(parsed.URL)
+		if err != nil {
+			return "", fmt.Errorf("failed to fetch url: %v", err)
+		}
+		defer resp.Body.Close()
+
+		body, err := io.ReadAll(io.LimitReader(resp.Body, 64*1024))
+		if err != nil {
+			return "", fmt.Errorf("failed to read response: %v", err)
+		}
+
+		return string(body), nil
+	})
+}
+
+// execArgs is the schema for the shell_exec tool's arguments.
+type execArgs struct {
+	Command string `json:"command"`
+}
+
+// RegisterShellExecTool registers a shell_exec tool that runs a command via
+// `sh -c` and returns its combined output. Intended for trusted, local use only.
+func RegisterShellExecTool(r *ToolRegistry) {
+	r.Register("shell_exec", map[string]interface{}{
+		"type": "object",
+		"properties": map[string]interface{}{
+			"command": map[string]interface{}{
+				"type":        "string",
+				"description": "The shell command to execute",
+			},
+		},
+		"required": []string{"command"},
+	}, "Execute a shell command and return its output.", func(args json.RawMessage) (string, error) {
+		var parsed execArgs
+		if err := json.Unmarshal(args, &parsed); err != nil {
+			return "", fmt.Errorf("failed to unmarshal tool arguments: %v", err)
+		}
+
+		cmd := exec.Command("sh", "-c", parsed.Command)
+		output, err := cmd.CombinedOutput()
+		if err != nil {
+			return "", fmt.Errorf("command failed: %v: %s", err, output)
+		}
+
+		return string(output), nil
+	})
+}
@@ -0,0 +1,32 @@
+package agent
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/walterfan/lazy-rabbit-agent/internal/llm"
+	"github.com/xeipuuv/gojsonschema"
+)
+
+// validateArguments checks toolCall's arguments against tool's declared JSON
+// Schema Parameters, returning a descriptive error - covering `required`
+// arrays and `enum` constraints on both scalar and array-typed properties -
+// if they don't conform.
+func validateArguments(tool llm.Tool, toolCall llm.ToolCall) error {
+	schemaLoader := gojsonschema.NewGoLoader(tool.Function.Parameters)
+	docLoader := gojsonschema.NewStringLoader(toolCall.Function.Arguments)
+
+	result, err := gojsonschema.Validate(schemaLoader, docLoader)
+	if err != nil {
+		return fmt.Errorf("failed to validate arguments for %s: %v", toolCall.Function.Name, err)
+	}
+	if result.Valid() {
+		return nil
+	}
+
+	messages := make([]string, 0, len(result.Errors()))
+	for _, e := range result.Errors() {
+		messages = append(messages, e.String())
+	}
+	return fmt.Errorf("invalid arguments for %s: %s", toolCall.Function.Name, strings.Join(messages, "; "))
+}
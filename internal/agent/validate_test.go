@@ -0,0 +1,51 @@
+package agent
+
+import (
+	"testing"
+
+	"github.com/walterfan/lazy-rabbit-agent/internal/llm"
+)
+
+func weatherSchemaTool() llm.Tool {
+	return llm.Tool{
+		Type: "function",
+		Function: llm.Function{
+			Name: "get_weather",
+			Parameters: map[string]interface{}{
+				"type": "object",
+				"properties": map[string]interface{}{
+					"location": map[string]interface{}{"type": "string"},
+					"units":    map[string]interface{}{"type": "string", "enum": []string{"metric", "imperial"}},
+				},
+				"required": []string{"location"},
+			},
+		},
+	}
+}
+
+func TestValidateArgumentsRejectsMissingRequired(t *testing.T) {
+	tool := weatherSchemaTool()
+	toolCall := llm.ToolCall{Function: llm.Function{Name: "get_weather", Arguments: `{"units":"metric"}`}}
+
+	if err := validateArguments(tool, toolCall); err == nil {
+		t.Fatal("expected an error for missing required field 'location'")
+	}
+}
+
+func TestValidateArgumentsRejectsBadEnum(t *testing.T) {
+	tool := weatherSchemaTool()
+	toolCall := llm.ToolCall{Function: llm.Function{Name: "get_weather", Arguments: `{"location":"Hefei","units":"kelvin"}`}}
+
+	if err := validateArguments(tool, toolCall); err == nil {
+		t.Fatal("expected an error for units outside the declared enum")
+	}
+}
+
+func TestValidateArgumentsAcceptsValidInput(t *testing.T) {
+	tool := weatherSchemaTool()
+	toolCall := llm.ToolCall{Function: llm.Function{Name: "get_weather", Arguments: `{"location":"Hefei","units":"imperial"}`}}
+
+	if err := validateArguments(tool, toolCall); err != nil {
+		t.Fatalf("expected valid arguments to pass, got %v", err)
+	}
+}
@@ -0,0 +1,59 @@
+package llm
+
+import (
+	"context"
+	"fmt"
+	"os"
+)
+
+// ChatRequest is a provider-agnostic chat completion request.
+type ChatRequest struct {
+	Messages   []Message
+	Tools      []Tool
+	ToolChoice ToolChoice
+}
+
+// ChatResponse is a provider-agnostic chat completion response.
+type ChatResponse struct {
+	Message Message
+}
+
+// Client sends chat completion requests to an LLM backend.
+type Client interface {
+	Chat(ctx context.Context, req ChatRequest) (*ChatResponse, error)
+}
+
+// NewClientFromEnv builds a Client selected by the LLM_PROVIDER environment
+// variable, defaulting to "openai".
+func NewClientFromEnv() (Client, error) {
+	provider := os.Getenv("LLM_PROVIDER")
+	if provider == "" {
+		provider = "openai"
+	}
+	return NewClient(provider)
+}
+
+// NewClient builds the named Client, reading any API keys, base URLs and
+// model names it needs from the environment. "openai", "moonshot", "chatglm",
+// "deepseek", "qwen" and "baichuan" all speak the same OpenAI-compatible wire
+// format and share OpenAIClient, configured via LLM_API_KEY, LLM_BASE_URL and
+// LLM_MODEL. "gemini" uses GeminiClient, configured via GEMINI_API_KEY and
+// LLM_MODEL.
+func NewClient(provider string) (Client, error) {
+	switch provider {
+	case "openai", "moonshot", "chatglm", "deepseek", "qwen", "baichuan":
+		apiKey, baseURL, model := os.Getenv("LLM_API_KEY"), os.Getenv("LLM_BASE_URL"), os.Getenv("LLM_MODEL")
+		if apiKey == "" || baseURL == "" || model == "" {
+			return nil, fmt.Errorf("LLM_API_KEY, LLM_BASE_URL and LLM_MODEL environment variables are required for provider %q", provider)
+		}
+		return NewOpenAIClient(apiKey, baseURL, model), nil
+	case "gemini":
+		apiKey, model := os.Getenv("GEMINI_API_KEY"), os.Getenv("LLM_MODEL")
+		if apiKey == "" || model == "" {
+			return nil, fmt.Errorf("GEMINI_API_KEY and LLM_MODEL environment variables are required for provider %q", provider)
+		}
+		return NewGeminiClient(apiKey, model), nil
+	default:
+		return nil, fmt.Errorf("unknown LLM provider %q", provider)
+	}
+}
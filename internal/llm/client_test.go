@@ -0,0 +1,33 @@
+package llm
+
+import "testing"
+
+func TestNewClientRejectsUnknownProvider(t *testing.T) {
+	if _, err := NewClient("not-a-real-provider"); err == nil {
+		t.Fatal("expected an error for an unknown provider")
+	}
+}
+
+func TestNewClientRejectsMissingOpenAIConfig(t *testing.T) {
+	t.Setenv("LLM_API_KEY", "")
+	t.Setenv("LLM_BASE_URL", "")
+	t.Setenv("LLM_MODEL", "")
+
+	if _, err := NewClient("openai"); err == nil {
+		t.Fatal("expected an error when LLM_API_KEY/LLM_BASE_URL/LLM_MODEL are unset")
+	}
+}
+
+func TestNewClientBuildsOpenAICompatibleClientForSharedProviders(t *testing.T) {
+	t.Setenv("LLM_API_KEY", "key")
+	t.Setenv("LLM_BASE_URL", "https://api.moonshot.cn/v1")
+	t.Setenv("LLM_MODEL", "moonshot-v1-8k")
+
+	client, err := NewClient("moonshot")
+	if err != nil {
+		t.Fatalf("NewClient returned error: %v", err)
+	}
+	if _, ok := client.(*OpenAIClient); !ok {
+		t.Fatalf("expected moonshot to use OpenAIClient, got %T", client)
+	}
+}
@@ -0,0 +1,190 @@
+package llm
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+)
+
+// GeminiClient implements Client against Google's Gemini generateContent API,
+// translating between the provider-agnostic Message/Tool/ToolCall types and
+// Gemini's Content/FunctionDeclaration/FunctionCall shapes.
+type GeminiClient struct {
+	APIKey string
+	Model  string
+
+	httpClient *http.Client
+}
+
+// NewGeminiClient returns a Client that sends generateContent requests for
+// model, authenticated with apiKey.
+func NewGeminiClient(apiKey, model string) *GeminiClient {
+	return &GeminiClient{APIKey: apiKey, Model: model, httpClient: &http.Client{}}
+}
+
+type geminiPart struct {
+	Text             string                  `json:"text,omitempty"`
+	FunctionCall     *geminiFunctionCall     `json:"functionCall,omitempty"`
+	FunctionResponse *geminiFunctionResponse `json:"functionResponse,omitempty"`
+}
+
+type geminiFunctionCall struct {
+	Name string                 `json:"name"`
+	Args map[string]interface{} `json:"args"`
+}
+
+type geminiFunctionResponse struct {
+	Name     string                 `json:"name"`
+	Response map[string]interface{} `json:"response"`
+}
+
+type geminiContent struct {
+	Role  string       `json:"role"`
+	Parts []geminiPart `json:"parts"`
+}
+
+type geminiFunctionDeclaration struct {
+	Name        string                 `json:"name"`
+	Description string                 `json:"description"`
+	Parameters  map[string]interface{} `json:"parameters"`
+}
+
+type geminiTool struct {
+	FunctionDeclarations []geminiFunctionDeclaration `json:"functionDeclarations"`
+}
+
+type geminiRequest struct {
+	Contents []geminiContent `json:"contents"`
+	Tools    []geminiTool    `json:"tools,omitempty"`
+}
+
+type geminiResponse struct {
+	Candidates []struct {
+		Content geminiContent `json:"content"`
+	} `json:"candidates"`
+}
+
+// Chat implements Client.
+func (c *GeminiClient) Chat(ctx context.Context, req ChatRequest) (*ChatResponse, error) {
+	request := geminiRequest{
+		Contents: toGeminiContents(req.Messages),
+		Tools:    toGeminiTools(req.Tools),
+	}
+
+	jsonData, err := json.Marshal(request)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal request: %v", err)
+	}
+
+	url := fmt.Sprintf("https://generativelanguage.googleapis.com/v1beta/models/%s:generateContent?key=%s", c.Model, c.APIKey)
+	httpReq, err := http.NewRequestWithContext(ctx, "POST", url, bytes.NewBuffer(jsonData))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %v", err)
+	}
+	httpReq.Header.Set("Content-Type", "application/json")
+
+	resp, err := c.httpClient.Do(httpReq)
+	if err != nil {
+		return nil, fmt.Errorf("failed to send request: %v", err)
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read response: %v", err)
+	}
+
+	var geminiResp geminiResponse
+	if err := json.Unmarshal(body, &geminiResp); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal response: %v", err)
+	}
+	if len(geminiResp.Candidates) == 0 {
+		return nil, fmt.Errorf("no candidates in response")
+	}
+
+	return &ChatResponse{Message: fromGeminiContent(geminiResp.Candidates[0].Content)}, nil
+}
+
+// toGeminiContents translates Messages into Gemini's Content/role shape.
+// The "assistant" role becomes Gemini's "model", and a "tool" message becomes
+// a functionResponse part on a "user"-role Content.
+func toGeminiContents(messages []Message) []geminiContent {
+	contents := make([]geminiContent, 0, len(messages))
+	callNames := make(map[string]string) // ToolCallID -> function name, populated as assistant messages are seen.
+	for _, m := range messages {
+		switch m.Role {
+		case "system":
+			continue // Gemini takes system instructions out-of-band; nothing to translate here yet.
+		case "tool":
+			name := callNames[m.ToolCallID]
+			if name == "" {
+				name = m.ToolCallID // best effort if the originating call wasn't in this message list
+			}
+			contents = append(contents, geminiContent{
+				Role: "user",
+				Parts: []geminiPart{{FunctionResponse: &geminiFunctionResponse{
+					Name:     name,
+					Response: map[string]interface{}{"result": m.Content},
+				}}},
+			})
+		case "assistant":
+			parts := make([]geminiPart, 0, 1+len(m.ToolCalls))
+			if m.Content != "" {
+				parts = append(parts, geminiPart{Text: m.Content})
+			}
+			for _, tc := range m.ToolCalls {
+				callNames[tc.ID] = tc.Function.Name
+				var args map[string]interface{}
+				json.Unmarshal([]byte(tc.Function.Arguments), &args)
+				parts = append(parts, geminiPart{FunctionCall: &geminiFunctionCall{Name: tc.Function.Name, Args: args}})
+			}
+			contents = append(contents, geminiContent{Role: "model", Parts: parts})
+		default:
+			contents = append(contents, geminiContent{Role: "user", Parts: []geminiPart{{Text: m.Content}}})
+		}
+	}
+	return contents
+}
+
+// toGeminiTools translates Tools into Gemini's FunctionDeclaration shape.
+func toGeminiTools(tools []Tool) []geminiTool {
+	if len(tools) == 0 {
+		return nil
+	}
+
+	decls := make([]geminiFunctionDeclaration, 0, len(tools))
+	for _, t := range tools {
+		decls = append(decls, geminiFunctionDeclaration{
+			Name:        t.Function.Name,
+			Description: t.Function.Description,
+			Parameters:  t.Function.Parameters,
+		})
+	}
+	return []geminiTool{{FunctionDeclarations: decls}}
+}
+
+// fromGeminiContent translates a Gemini response Content back into a Message,
+// synthesizing ToolCalls from any functionCall parts.
+func fromGeminiContent(content geminiContent) Message {
+	message := Message{Role: "assistant"}
+	for i, part := range content.Parts {
+		if part.Text != "" {
+			message.Content += part.Text
+		}
+		if part.FunctionCall != nil {
+			argsJSON, _ := json.Marshal(part.FunctionCall.Args)
+			message.ToolCalls = append(message.ToolCalls, ToolCall{
+				ID:   fmt.Sprintf("call_%d", i),
+				Type: "function",
+				Function: Function{
+					Name:      part.FunctionCall.Name,
+					Arguments: string(argsJSON),
+				},
+			})
+		}
+	}
+	return message
+}
@@ -0,0 +1,44 @@
+package llm
+
+import "testing"
+
+func TestToGeminiContentsTranslatesToolRoundTrip(t *testing.T) {
+	messages := []Message{
+		{Role: "user", Content: "How's the weather in Hefei?"},
+		{Role: "assistant", ToolCalls: []ToolCall{
+			{ID: "call_1", Function: Function{Name: "get_weather", Arguments: `{"location":"Hefei"}`}},
+		}},
+		{Role: "tool", ToolCallID: "call_1", Content: "Hefei is sunny, 30.0C"},
+	}
+
+	contents := toGeminiContents(messages)
+	if len(contents) != 3 {
+		t.Fatalf("expected 3 contents, got %d", len(contents))
+	}
+
+	if contents[1].Role != "model" || contents[1].Parts[0].FunctionCall.Name != "get_weather" {
+		t.Fatalf("expected assistant tool call translated to a model functionCall part, got %+v", contents[1])
+	}
+
+	if contents[2].Role != "user" || contents[2].Parts[0].FunctionResponse.Name != "get_weather" {
+		t.Fatalf("expected tool message translated to a user functionResponse part naming the called function, got %+v", contents[2])
+	}
+}
+
+func TestFromGeminiContentSynthesizesToolCalls(t *testing.T) {
+	content := geminiContent{
+		Role: "model",
+		Parts: []geminiPart{
+			{Text: "Let me check."},
+			{FunctionCall: &geminiFunctionCall{Name: "get_weather", Args: map[string]interface{}{"location": "Hefei"}}},
+		},
+	}
+
+	message := fromGeminiContent(content)
+	if message.Content != "Let me check." {
+		t.Fatalf("expected text content to carry over, got %q", message.Content)
+	}
+	if len(message.ToolCalls) != 1 || message.ToolCalls[0].Function.Name != "get_weather" {
+		t.Fatalf("expected a synthesized get_weather tool call, got %+v", message.ToolCalls)
+	}
+}
@@ -0,0 +1,94 @@
+package llm
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+)
+
+// OpenAIClient implements Client against any OpenAI-compatible chat
+// completions endpoint. Moonshot, ChatGLM, DeepSeek, Qwen and Baichuan all
+// expose this same request/response shape, so a single client covers them -
+// only BaseURL and Model need to change.
+type OpenAIClient struct {
+	APIKey  string
+	BaseURL string
+	Model   string
+
+	httpClient *http.Client
+}
+
+// NewOpenAIClient returns a Client that sends chat completions to baseURL
+// using model, authenticated with apiKey.
+func NewOpenAIClient(apiKey, baseURL, model string) *OpenAIClient {
+	return &OpenAIClient{APIKey: apiKey, BaseURL: baseURL, Model: model, httpClient: &http.Client{}}
+}
+
+// openAIRequest is the body sent to an OpenAI-compatible chat completions endpoint.
+type openAIRequest struct {
+	Model      string      `json:"model"`
+	Messages   []Message   `json:"messages"`
+	Tools      []Tool      `json:"tools,omitempty"`
+	ToolChoice *ToolChoice `json:"tool_choice,omitempty"`
+}
+
+// newOpenAIRequest builds the shared request body for Chat and ChatStream.
+// ToolChoice is only meaningful alongside tools, and a struct-typed field
+// can't be omitted via omitempty, so it's left nil (and thus absent from the
+// JSON) whenever there are no tools - some OpenAI-compatible endpoints 400 on
+// a tool_choice with no tools to choose from.
+func newOpenAIRequest(model string, req ChatRequest) openAIRequest {
+	request := openAIRequest{Model: model, Messages: req.Messages, Tools: req.Tools}
+	if len(req.Tools) > 0 {
+		request.ToolChoice = &req.ToolChoice
+	}
+	return request
+}
+
+// openAIResponse is the body returned by an OpenAI-compatible chat completions endpoint.
+type openAIResponse struct {
+	Choices []struct {
+		Message Message `json:"message"`
+	} `json:"choices"`
+}
+
+// Chat implements Client.
+func (c *OpenAIClient) Chat(ctx context.Context, req ChatRequest) (*ChatResponse, error) {
+	request := newOpenAIRequest(c.Model, req)
+
+	jsonData, err := json.Marshal(request)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal request: %v", err)
+	}
+
+	httpReq, err := http.NewRequestWithContext(ctx, "POST", c.BaseURL+"/chat/completions", bytes.NewBuffer(jsonData))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %v", err)
+	}
+	httpReq.Header.Set("Content-Type", "application/json")
+	httpReq.Header.Set("Authorization", "Bearer "+c.APIKey)
+
+	resp, err := c.httpClient.Do(httpReq)
+	if err != nil {
+		return nil, fmt.Errorf("failed to send request: %v", err)
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read response: %v", err)
+	}
+
+	var openAIResp openAIResponse
+	if err := json.Unmarshal(body, &openAIResp); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal response: %v", err)
+	}
+	if len(openAIResp.Choices) == 0 {
+		return nil, fmt.Errorf("no choices in response")
+	}
+
+	return &ChatResponse{Message: openAIResp.Choices[0].Message}, nil
+}
@@ -0,0 +1,53 @@
+package llm
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestChatOmitsToolChoiceWhenNoToolsAreRegistered(t *testing.T) {
+	var body map[string]interface{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		json.NewDecoder(r.Body).Decode(&body)
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"choices":[{"message":{"role":"assistant","content":"hi"}}]}`))
+	}))
+	defer server.Close()
+
+	client := NewOpenAIClient("test-key", server.URL, "test-model")
+	if _, err := client.Chat(context.Background(), ChatRequest{Messages: []Message{{Role: "user", Content: "hello"}}}); err != nil {
+		t.Fatalf("Chat returned error: %v", err)
+	}
+
+	if _, ok := body["tool_choice"]; ok {
+		t.Fatalf("expected tool_choice to be omitted when there are no tools, got body %+v", body)
+	}
+}
+
+func TestChatIncludesToolChoiceWhenToolsAreRegistered(t *testing.T) {
+	var body map[string]interface{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		json.NewDecoder(r.Body).Decode(&body)
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"choices":[{"message":{"role":"assistant","content":"hi"}}]}`))
+	}))
+	defer server.Close()
+
+	client := NewOpenAIClient("test-key", server.URL, "test-model")
+	req := ChatRequest{
+		Messages:   []Message{{Role: "user", Content: "hello"}},
+		Tools:      []Tool{{Type: "function", Function: Function{Name: "get_weather"}}},
+		ToolChoice: ToolChoiceAuto,
+	}
+
+	if _, err := client.Chat(context.Background(), req); err != nil {
+		t.Fatalf("Chat returned error: %v", err)
+	}
+
+	if body["tool_choice"] != "auto" {
+		t.Fatalf("expected tool_choice %q to be sent alongside tools, got body %+v", "auto", body)
+	}
+}
@@ -0,0 +1,179 @@
+package llm
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+)
+
+// StreamEventType identifies the kind of event delivered on a ChatStream channel.
+type StreamEventType int
+
+const (
+	// ContentDelta carries a fragment of assistant text.
+	ContentDelta StreamEventType = iota
+	// ToolCallDelta carries a fully-assembled tool call, emitted once its
+	// arguments have finished streaming.
+	ToolCallDelta
+	// Done signals the stream has finished; Err is set if it finished abnormally.
+	Done
+)
+
+// StreamEvent is a single event from a streamed chat completion.
+type StreamEvent struct {
+	Type     StreamEventType
+	Content  string
+	ToolCall ToolCall
+	Err      error
+}
+
+// StreamingClient is implemented by Clients that support streamed chat
+// completions; only OpenAIClient does today.
+type StreamingClient interface {
+	Client
+	ChatStream(ctx context.Context, req ChatRequest) (<-chan StreamEvent, error)
+}
+
+// streamChunk mirrors a single `data:` line of an OpenAI-compatible SSE chat
+// completions stream.
+type streamChunk struct {
+	Choices []struct {
+		Delta struct {
+			Content   string `json:"content"`
+			ToolCalls []struct {
+				Index    int    `json:"index"`
+				ID       string `json:"id"`
+				Function struct {
+					Name      string `json:"name"`
+					Arguments string `json:"arguments"`
+				} `json:"function"`
+			} `json:"tool_calls"`
+		} `json:"delta"`
+		FinishReason string `json:"finish_reason"`
+	} `json:"choices"`
+}
+
+// toolCallBuffer accumulates the fragmented id/name/arguments of a single
+// tool call across successive stream chunks, keyed by its tool_calls[i].index.
+type toolCallBuffer struct {
+	id        string
+	name      string
+	arguments strings.Builder
+}
+
+// ChatStream implements StreamingClient for OpenAI-compatible endpoints,
+// opening a `stream: true` request and returning a channel of StreamEvents.
+// The channel is closed after a Done event.
+func (c *OpenAIClient) ChatStream(ctx context.Context, req ChatRequest) (<-chan StreamEvent, error) {
+	request := struct {
+		openAIRequest
+		Stream bool `json:"stream"`
+	}{
+		openAIRequest: newOpenAIRequest(c.Model, req),
+		Stream:        true,
+	}
+
+	jsonData, err := json.Marshal(request)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal request: %v", err)
+	}
+
+	httpReq, err := http.NewRequestWithContext(ctx, "POST", c.BaseURL+"/chat/completions", bytes.NewBuffer(jsonData))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %v", err)
+	}
+	httpReq.Header.Set("Content-Type", "application/json")
+	httpReq.Header.Set("Accept", "text/event-stream")
+	httpReq.Header.Set("Authorization", "Bearer "+c.APIKey)
+
+	resp, err := c.httpClient.Do(httpReq)
+	if err != nil {
+		return nil, fmt.Errorf("failed to send request: %v", err)
+	}
+
+	events := make(chan StreamEvent)
+	go readStream(resp, events)
+	return events, nil
+}
+
+// readStream decodes SSE `data:` lines from resp.Body, accumulating
+// fragmented tool-call arguments per tool_calls[i].index and only emitting a
+// ToolCallDelta once finish_reason signals the call is complete.
+func readStream(resp *http.Response, events chan<- StreamEvent) {
+	defer close(events)
+	defer resp.Body.Close()
+
+	buffers := make(map[int]*toolCallBuffer)
+	scanner := bufio.NewScanner(resp.Body)
+
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || !strings.HasPrefix(line, "data:") {
+			continue
+		}
+		data := strings.TrimSpace(strings.TrimPrefix(line, "data:"))
+		if data == "[DONE]" {
+			break
+		}
+
+		var chunk streamChunk
+		if err := json.Unmarshal([]byte(data), &chunk); err != nil {
+			events <- StreamEvent{Type: Done, Err: fmt.Errorf("failed to unmarshal stream chunk: %v", err)}
+			return
+		}
+		if len(chunk.Choices) == 0 {
+			continue
+		}
+		choice := chunk.Choices[0]
+
+		if choice.Delta.Content != "" {
+			events <- StreamEvent{Type: ContentDelta, Content: choice.Delta.Content}
+		}
+
+		for _, tc := range choice.Delta.ToolCalls {
+			buf, ok := buffers[tc.Index]
+			if !ok {
+				buf = &toolCallBuffer{}
+				buffers[tc.Index] = buf
+			}
+			if tc.ID != "" {
+				buf.id = tc.ID
+			}
+			if tc.Function.Name != "" {
+				buf.name = tc.Function.Name
+			}
+			buf.arguments.WriteString(tc.Function.Arguments)
+		}
+
+		if choice.FinishReason == "tool_calls" {
+			indexes := make([]int, 0, len(buffers))
+			for i := range buffers {
+				indexes = append(indexes, i)
+			}
+			sort.Ints(indexes)
+
+			for _, i := range indexes {
+				buf := buffers[i]
+				events <- StreamEvent{Type: ToolCallDelta, ToolCall: ToolCall{
+					ID:   buf.id,
+					Type: "function",
+					Function: Function{
+						Name:      buf.name,
+						Arguments: buf.arguments.String(),
+					},
+				}}
+			}
+		}
+	}
+
+	if err := scanner.Err(); err != nil {
+		events <- StreamEvent{Type: Done, Err: fmt.Errorf("failed to read stream: %v", err)}
+		return
+	}
+	events <- StreamEvent{Type: Done}
+}
@@ -0,0 +1,74 @@
+package llm
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestChatStreamReassemblesFragmentedToolCallArguments(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/event-stream")
+		fmt.Fprint(w, "data: {\"choices\":[{\"delta\":{\"tool_calls\":[{\"index\":0,\"id\":\"call_1\",\"function\":{\"name\":\"get_weather\",\"arguments\":\"{\\\"locat\"}}]}}]}\n\n")
+		fmt.Fprint(w, "data: {\"choices\":[{\"delta\":{\"tool_calls\":[{\"index\":0,\"function\":{\"arguments\":\"ion\\\":\\\"Hefei\\\"}\"}}]}}]}\n\n")
+		fmt.Fprint(w, "data: {\"choices\":[{\"delta\":{},\"finish_reason\":\"tool_calls\"}]}\n\n")
+		fmt.Fprint(w, "data: [DONE]\n\n")
+	}))
+	defer server.Close()
+
+	client := NewOpenAIClient("test-key", server.URL, "test-model")
+	events, err := client.ChatStream(context.Background(), ChatRequest{Messages: []Message{{Role: "user", Content: "weather?"}}})
+	if err != nil {
+		t.Fatalf("ChatStream returned error: %v", err)
+	}
+
+	var toolCalls []ToolCall
+	for ev := range events {
+		if ev.Err != nil {
+			t.Fatalf("unexpected stream error: %v", ev.Err)
+		}
+		if ev.Type == ToolCallDelta {
+			toolCalls = append(toolCalls, ev.ToolCall)
+		}
+	}
+
+	if len(toolCalls) != 1 {
+		t.Fatalf("expected 1 reassembled tool call, got %d: %+v", len(toolCalls), toolCalls)
+	}
+	if got := toolCalls[0].Function.Arguments; got != `{"location":"Hefei"}` {
+		t.Fatalf("expected reassembled arguments %q, got %q", `{"location":"Hefei"}`, got)
+	}
+}
+
+func TestChatStreamFlushesToolCallsInIndexOrderForSparseIndexes(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/event-stream")
+		fmt.Fprint(w, "data: {\"choices\":[{\"delta\":{\"tool_calls\":[{\"index\":2,\"id\":\"call_2\",\"function\":{\"name\":\"b\",\"arguments\":\"{}\"}}]}}]}\n\n")
+		fmt.Fprint(w, "data: {\"choices\":[{\"delta\":{\"tool_calls\":[{\"index\":0,\"id\":\"call_0\",\"function\":{\"name\":\"a\",\"arguments\":\"{}\"}}]}}]}\n\n")
+		fmt.Fprint(w, "data: {\"choices\":[{\"delta\":{},\"finish_reason\":\"tool_calls\"}]}\n\n")
+		fmt.Fprint(w, "data: [DONE]\n\n")
+	}))
+	defer server.Close()
+
+	client := NewOpenAIClient("test-key", server.URL, "test-model")
+	events, err := client.ChatStream(context.Background(), ChatRequest{Messages: []Message{{Role: "user", Content: "go"}}})
+	if err != nil {
+		t.Fatalf("ChatStream returned error: %v", err)
+	}
+
+	var toolCalls []ToolCall
+	for ev := range events {
+		if ev.Type == ToolCallDelta {
+			toolCalls = append(toolCalls, ev.ToolCall)
+		}
+	}
+
+	if len(toolCalls) != 2 {
+		t.Fatalf("expected 2 reassembled tool calls despite the sparse indexes, got %d: %+v", len(toolCalls), toolCalls)
+	}
+	if toolCalls[0].ID != "call_0" || toolCalls[1].ID != "call_2" {
+		t.Fatalf("expected tool calls flushed in index order (call_0, call_2), got %+v", toolCalls)
+	}
+}
@@ -0,0 +1,71 @@
+package llm
+
+import "encoding/json"
+
+// ToolChoice controls whether and how the model should call a tool. It
+// marshals to the plain strings "auto", "none" or "required", or to
+// {"type":"function","function":{"name":"..."}} when pinned to one function.
+type ToolChoice struct {
+	mode         string
+	functionName string
+}
+
+// Predefined ToolChoice values matching the OpenAI chat completions API.
+var (
+	ToolChoiceAuto     = ToolChoice{mode: "auto"}
+	ToolChoiceNone     = ToolChoice{mode: "none"}
+	ToolChoiceRequired = ToolChoice{mode: "required"}
+)
+
+// ToolChoiceFunction pins the model to calling the named function.
+func ToolChoiceFunction(name string) ToolChoice {
+	return ToolChoice{mode: "function", functionName: name}
+}
+
+// MarshalJSON implements json.Marshaler.
+func (c ToolChoice) MarshalJSON() ([]byte, error) {
+	if c.mode == "function" {
+		return json.Marshal(struct {
+			Type     string `json:"type"`
+			Function struct {
+				Name string `json:"name"`
+			} `json:"function"`
+		}{
+			Type: "function",
+			Function: struct {
+				Name string `json:"name"`
+			}{Name: c.functionName},
+		})
+	}
+
+	mode := c.mode
+	if mode == "" {
+		mode = "auto"
+	}
+	return json.Marshal(mode)
+}
+
+// FunctionCallingMode mirrors the Gemini function-calling config's ANY/AUTO/NONE
+// modes and maps onto the OpenAI-style ToolChoice values above.
+type FunctionCallingMode int
+
+const (
+	// FunctionCallingAuto lets the model decide whether to call a tool.
+	FunctionCallingAuto FunctionCallingMode = iota
+	// FunctionCallingAny forces the model to call one of the registered tools.
+	FunctionCallingAny
+	// FunctionCallingNone disables tool calling entirely.
+	FunctionCallingNone
+)
+
+// ToolChoice returns the ToolChoice equivalent to m.
+func (m FunctionCallingMode) ToolChoice() ToolChoice {
+	switch m {
+	case FunctionCallingAny:
+		return ToolChoiceRequired
+	case FunctionCallingNone:
+		return ToolChoiceNone
+	default:
+		return ToolChoiceAuto
+	}
+}
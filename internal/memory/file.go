@@ -0,0 +1,62 @@
+package memory
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/walterfan/lazy-rabbit-agent/internal/llm"
+)
+
+// FileStore persists each session as its own JSON file under Dir.
+type FileStore struct {
+	Dir string
+}
+
+// NewFileStore returns a FileStore rooted at dir, creating it if necessary.
+func NewFileStore(dir string) (*FileStore, error) {
+	if err := os.MkdirAll(dir, 0o700); err != nil {
+		return nil, fmt.Errorf("failed to create session directory: %v", err)
+	}
+	return &FileStore{Dir: dir}, nil
+}
+
+// DefaultDir returns ~/.lazy-rabbit/sessions, the default home for FileStore.
+func DefaultDir() (string, error) {
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return "", fmt.Errorf("failed to resolve home directory: %v", err)
+	}
+	return filepath.Join(home, ".lazy-rabbit", "sessions"), nil
+}
+
+func (s *FileStore) path(id string) string {
+	return filepath.Join(s.Dir, id+".json")
+}
+
+// Load implements Store.
+func (s *FileStore) Load(id string) ([]llm.Message, error) {
+	data, err := os.ReadFile(s.path(id))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read session %q: %v", id, err)
+	}
+
+	var messages []llm.Message
+	if err := json.Unmarshal(data, &messages); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal session %q: %v", id, err)
+	}
+	return messages, nil
+}
+
+// Save implements Store.
+func (s *FileStore) Save(id string, messages []llm.Message) error {
+	data, err := json.MarshalIndent(messages, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to marshal session %q: %v", id, err)
+	}
+	if err := os.WriteFile(s.path(id), data, 0o600); err != nil {
+		return fmt.Errorf("failed to write session %q: %v", id, err)
+	}
+	return nil
+}
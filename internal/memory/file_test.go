@@ -0,0 +1,56 @@
+package memory
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/walterfan/lazy-rabbit-agent/internal/llm"
+)
+
+func TestFileStoreSavesAndLoadsSession(t *testing.T) {
+	store, err := NewFileStore(filepath.Join(t.TempDir(), "sessions"))
+	if err != nil {
+		t.Fatalf("NewFileStore returned error: %v", err)
+	}
+
+	messages := []llm.Message{{Role: "user", Content: "hello"}}
+	if err := store.Save("test-session", messages); err != nil {
+		t.Fatalf("Save returned error: %v", err)
+	}
+
+	loaded, err := store.Load("test-session")
+	if err != nil {
+		t.Fatalf("Load returned error: %v", err)
+	}
+	if len(loaded) != 1 || loaded[0].Content != "hello" {
+		t.Fatalf("expected loaded session to round-trip, got %+v", loaded)
+	}
+}
+
+func TestFileStoreLoadMissingSessionFails(t *testing.T) {
+	store, err := NewFileStore(filepath.Join(t.TempDir(), "sessions"))
+	if err != nil {
+		t.Fatalf("NewFileStore returned error: %v", err)
+	}
+
+	if _, err := store.Load("does-not-exist"); err == nil {
+		t.Fatal("expected an error loading a session that was never saved")
+	}
+}
+
+func TestInMemoryStoreRoundTrip(t *testing.T) {
+	store := NewInMemoryStore()
+	messages := []llm.Message{{Role: "user", Content: "hi"}}
+
+	if err := store.Save("s1", messages); err != nil {
+		t.Fatalf("Save returned error: %v", err)
+	}
+
+	loaded, err := store.Load("s1")
+	if err != nil {
+		t.Fatalf("Load returned error: %v", err)
+	}
+	if len(loaded) != 1 || loaded[0].Content != "hi" {
+		t.Fatalf("expected loaded session to round-trip, got %+v", loaded)
+	}
+}
@@ -0,0 +1,41 @@
+package memory
+
+import (
+	"fmt"
+	"sync"
+
+	"github.com/walterfan/lazy-rabbit-agent/internal/llm"
+)
+
+// InMemoryStore keeps sessions in process memory; sessions are lost when the
+// process exits.
+type InMemoryStore struct {
+	mu       sync.Mutex
+	sessions map[string][]llm.Message
+}
+
+// NewInMemoryStore returns an empty InMemoryStore.
+func NewInMemoryStore() *InMemoryStore {
+	return &InMemoryStore{sessions: make(map[string][]llm.Message)}
+}
+
+// Load implements Store.
+func (s *InMemoryStore) Load(id string) ([]llm.Message, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	messages, ok := s.sessions[id]
+	if !ok {
+		return nil, fmt.Errorf("no session %q", id)
+	}
+	return messages, nil
+}
+
+// Save implements Store.
+func (s *InMemoryStore) Save(id string, messages []llm.Message) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.sessions[id] = messages
+	return nil
+}
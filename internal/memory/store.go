@@ -0,0 +1,9 @@
+package memory
+
+import "github.com/walterfan/lazy-rabbit-agent/internal/llm"
+
+// Store loads and saves a conversation's messages by session id.
+type Store interface {
+	Load(id string) ([]llm.Message, error)
+	Save(id string, messages []llm.Message) error
+}
@@ -0,0 +1,68 @@
+package memory
+
+import "github.com/walterfan/lazy-rabbit-agent/internal/llm"
+
+// approxTokens estimates the token count of s using the common ~4
+// characters-per-token heuristic for English/code text.
+func approxTokens(s string) int {
+	return (len(s) + 3) / 4
+}
+
+// Trim drops the oldest turns from messages until the total estimated token
+// count is within maxTokens (a no-op if maxTokens <= 0). It always preserves
+// a leading system prompt, and never splits an assistant tool-call message
+// from its matching "tool" response messages, which the OpenAI API rejects
+// as orphaned tool_call_ids.
+func Trim(messages []llm.Message, maxTokens int) []llm.Message {
+	if maxTokens <= 0 {
+		return messages
+	}
+
+	total := 0
+	for _, m := range messages {
+		total += approxTokens(m.Content)
+	}
+	if total <= maxTokens {
+		return messages
+	}
+
+	var system []llm.Message
+	rest := messages
+	if len(rest) > 0 && rest[0].Role == "system" {
+		system = rest[:1]
+		rest = rest[1:]
+	}
+
+	for total > maxTokens && len(rest) > 0 {
+		drop := dropSpan(rest)
+		for _, m := range rest[:drop] {
+			total -= approxTokens(m.Content)
+		}
+		rest = rest[drop:]
+	}
+
+	trimmed := make([]llm.Message, 0, len(system)+len(rest))
+	trimmed = append(trimmed, system...)
+	trimmed = append(trimmed, rest...)
+	return trimmed
+}
+
+// dropSpan returns how many leading messages of rest make up one logical
+// turn to drop as a unit: a lone message, or an assistant tool-call message
+// plus every "tool" message responding to it.
+func dropSpan(rest []llm.Message) int {
+	if rest[0].Role != "assistant" || len(rest[0].ToolCalls) == 0 {
+		return 1
+	}
+
+	pending := make(map[string]bool, len(rest[0].ToolCalls))
+	for _, tc := range rest[0].ToolCalls {
+		pending[tc.ID] = true
+	}
+
+	span := 1
+	for span < len(rest) && rest[span].Role == "tool" && pending[rest[span].ToolCallID] {
+		span++
+	}
+	return span
+}
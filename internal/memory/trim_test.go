@@ -0,0 +1,61 @@
+package memory
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/walterfan/lazy-rabbit-agent/internal/llm"
+)
+
+func TestTrimPreservesSystemPromptAndToolPairs(t *testing.T) {
+	long := strings.Repeat("x", 400) // ~100 estimated tokens
+
+	messages := []llm.Message{
+		{Role: "system", Content: "You are a helpful assistant."},
+		{Role: "user", Content: long},
+		{Role: "assistant", Content: long},
+		{Role: "user", Content: long},
+		{Role: "assistant", ToolCalls: []llm.ToolCall{{ID: "call_1"}}},
+		{Role: "tool", ToolCallID: "call_1", Content: long},
+		{Role: "user", Content: "latest question"},
+	}
+
+	trimmed := Trim(messages, 120)
+
+	if trimmed[0].Role != "system" {
+		t.Fatalf("expected system prompt to be preserved, got %+v", trimmed[0])
+	}
+	if got := trimmed[len(trimmed)-1].Content; got != "latest question" {
+		t.Fatalf("expected the latest turn to survive trimming, got %q", got)
+	}
+
+	for i, m := range trimmed {
+		if m.Role != "tool" {
+			continue
+		}
+		var foundPair bool
+		for j := i - 1; j >= 0; j-- {
+			if trimmed[j].Role != "assistant" {
+				continue
+			}
+			for _, tc := range trimmed[j].ToolCalls {
+				if tc.ID == m.ToolCallID {
+					foundPair = true
+				}
+			}
+			break
+		}
+		if !foundPair {
+			t.Fatalf("tool message %+v survived without its matching assistant tool call", m)
+		}
+	}
+}
+
+func TestTrimNoOpWhenUnderBudget(t *testing.T) {
+	messages := []llm.Message{{Role: "user", Content: "hi"}}
+
+	trimmed := Trim(messages, 1000)
+	if len(trimmed) != 1 {
+		t.Fatalf("expected no trimming under budget, got %+v", trimmed)
+	}
+}
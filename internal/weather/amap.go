@@ -0,0 +1,151 @@
+package weather
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+)
+
+// amapCityCodes maps common city names to AMap adcodes.
+var amapCityCodes = map[string]string{
+	"合肥市":   "340100",
+	"合肥":    "340100",
+	"HEFEI": "340100",
+	"芜湖市":   "340200",
+	"芜湖":    "340200",
+	"WUHU":  "340200",
+}
+
+// AMapProvider implements Provider against the AMap restapi.amap.com weather API.
+type AMapProvider struct {
+	APIKey     string
+	httpClient *http.Client
+}
+
+// NewAMapProvider returns a Provider backed by AMap, authenticated with apiKey.
+func NewAMapProvider(apiKey string) *AMapProvider {
+	return &AMapProvider{APIKey: apiKey, httpClient: &http.Client{}}
+}
+
+// adcode resolves a city name to its AMap adcode, defaulting to Hefei.
+func (p *AMapProvider) adcode(location string) string {
+	location = strings.ToUpper(strings.TrimSpace(location))
+	if code, ok := amapCityCodes[location]; ok {
+		return code
+	}
+	return "340100"
+}
+
+// amapWeatherResponse mirrors the fields of the AMap weatherInfo API response
+// that Provider needs, for both the "base" (live) and "all" (forecast) extensions.
+type amapWeatherResponse struct {
+	Lives []struct {
+		City        string `json:"city"`
+		Weather     string `json:"weather"`
+		Temperature string `json:"temperature"`
+		Humidity    string `json:"humidity"`
+		ReportTime  string `json:"reporttime"`
+	} `json:"lives"`
+	Forecasts []struct {
+		City  string `json:"city"`
+		Casts []struct {
+			Date       string `json:"date"`
+			DayWeather string `json:"dayweather"`
+			DayTemp    string `json:"daytemp"`
+		} `json:"casts"`
+	} `json:"forecasts"`
+}
+
+// Current implements Provider.
+func (p *AMapProvider) Current(ctx context.Context, location string) (*Report, error) {
+	resp, err := p.request(ctx, location, "base")
+	if err != nil {
+		return nil, err
+	}
+	if len(resp.Lives) == 0 {
+		return nil, fmt.Errorf("no weather data received for %q", location)
+	}
+
+	today := resp.Lives[0]
+	tempC := parseFloat(today.Temperature)
+	return &Report{
+		Location:   today.City,
+		Date:       today.ReportTime,
+		Conditions: today.Weather,
+		TempC:      tempC,
+		TempF:      celsiusToFahrenheit(tempC),
+		Humidity:   int(parseFloat(today.Humidity)),
+	}, nil
+}
+
+// Forecast implements Provider.
+func (p *AMapProvider) Forecast(ctx context.Context, location string, days int) ([]Report, error) {
+	resp, err := p.request(ctx, location, "all")
+	if err != nil {
+		return nil, err
+	}
+	if len(resp.Forecasts) == 0 {
+		return nil, fmt.Errorf("no forecast data received for %q", location)
+	}
+
+	casts := resp.Forecasts[0].Casts
+	if days > 0 && days < len(casts) {
+		casts = casts[:days]
+	}
+
+	reports := make([]Report, 0, len(casts))
+	for _, c := range casts {
+		tempC := parseFloat(c.DayTemp)
+		reports = append(reports, Report{
+			Location:   resp.Forecasts[0].City,
+			Date:       c.Date,
+			Conditions: c.DayWeather,
+			TempC:      tempC,
+			TempF:      celsiusToFahrenheit(tempC),
+		})
+	}
+	return reports, nil
+}
+
+func (p *AMapProvider) request(ctx context.Context, location, extensions string) (*amapWeatherResponse, error) {
+	req, err := http.NewRequestWithContext(ctx, "GET", "https://restapi.amap.com/v3/weather/weatherInfo", nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %v", err)
+	}
+	q := req.URL.Query()
+	q.Add("city", p.adcode(location))
+	q.Add("key", p.APIKey)
+	q.Add("extensions", extensions)
+	req.URL.RawQuery = q.Encode()
+
+	resp, err := p.httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to send request: %v", err)
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read response: %v", err)
+	}
+
+	var weatherResp amapWeatherResponse
+	if err := json.Unmarshal(body, &weatherResp); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal response: %v", err)
+	}
+	return &weatherResp, nil
+}
+
+// parseFloat parses an AMap numeric field, which is always encoded as a string.
+func parseFloat(s string) float64 {
+	var f float64
+	fmt.Sscanf(s, "%f", &f)
+	return f
+}
+
+func celsiusToFahrenheit(c float64) float64 {
+	return c*9/5 + 32
+}
@@ -0,0 +1,23 @@
+package weather
+
+import "testing"
+
+func TestAMapProviderAdcodeDefaultsToHefei(t *testing.T) {
+	p := NewAMapProvider("test-key")
+
+	if got := p.adcode("芜湖"); got != "340200" {
+		t.Fatalf("adcode(%q) = %q, want %q", "芜湖", got, "340200")
+	}
+	if got := p.adcode("unknown city"); got != "340100" {
+		t.Fatalf("adcode(unknown) = %q, want default %q", got, "340100")
+	}
+}
+
+func TestCelsiusToFahrenheit(t *testing.T) {
+	if got := celsiusToFahrenheit(0); got != 32 {
+		t.Fatalf("celsiusToFahrenheit(0) = %v, want 32", got)
+	}
+	if got := celsiusToFahrenheit(100); got != 212 {
+		t.Fatalf("celsiusToFahrenheit(100) = %v, want 212", got)
+	}
+}
@@ -0,0 +1,155 @@
+package weather
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// OpenWeatherMapProvider implements Provider against the OpenWeatherMap API.
+type OpenWeatherMapProvider struct {
+	APIKey     string
+	httpClient *http.Client
+}
+
+// NewOpenWeatherMapProvider returns a Provider backed by OpenWeatherMap,
+// authenticated with apiKey.
+func NewOpenWeatherMapProvider(apiKey string) *OpenWeatherMapProvider {
+	return &OpenWeatherMapProvider{APIKey: apiKey, httpClient: &http.Client{}}
+}
+
+// owmResponse mirrors the fields of OpenWeatherMap's current-weather response
+// (and, within List below, each entry of its 5-day/3-hour forecast response).
+type owmResponse struct {
+	Name    string `json:"name"`
+	Weather []struct {
+		Main string `json:"main"`
+	} `json:"weather"`
+	Main struct {
+		Temp     float64 `json:"temp"`
+		Humidity int     `json:"humidity"`
+	} `json:"main"`
+	Wind struct {
+		Speed float64 `json:"speed"`
+		Deg   int     `json:"deg"`
+	} `json:"wind"`
+	Sys struct {
+		Sunrise int64 `json:"sunrise"`
+		Sunset  int64 `json:"sunset"`
+	} `json:"sys"`
+	Dt int64 `json:"dt"`
+}
+
+type owmForecastResponse struct {
+	City struct {
+		Name string `json:"name"`
+	} `json:"city"`
+	List []owmResponse `json:"list"`
+}
+
+// query builds the location-identifying parameters OpenWeatherMap expects:
+// "lat,lon" is passed through as coordinates, anything else as a city name.
+func (p *OpenWeatherMapProvider) query(location string) url.Values {
+	v := url.Values{}
+	v.Set("appid", p.APIKey)
+	lat, lon, ok := strings.Cut(location, ",")
+	lat, lon = strings.TrimSpace(lat), strings.TrimSpace(lon)
+	_, latErr := strconv.ParseFloat(lat, 64)
+	_, lonErr := strconv.ParseFloat(lon, 64)
+	if ok && latErr == nil && lonErr == nil {
+		v.Set("lat", lat)
+		v.Set("lon", lon)
+	} else {
+		v.Set("q", location)
+	}
+	return v
+}
+
+// Current implements Provider.
+func (p *OpenWeatherMapProvider) Current(ctx context.Context, location string) (*Report, error) {
+	var resp owmResponse
+	if err := p.get(ctx, "https://api.openweathermap.org/data/2.5/weather", p.query(location), &resp); err != nil {
+		return nil, err
+	}
+	return toReport(resp), nil
+}
+
+// Forecast implements Provider. OpenWeatherMap's free forecast endpoint
+// returns one reading every 3 hours; Forecast takes one reading per day to
+// approximate a daily forecast.
+func (p *OpenWeatherMapProvider) Forecast(ctx context.Context, location string, days int) ([]Report, error) {
+	var resp owmForecastResponse
+	if err := p.get(ctx, "https://api.openweathermap.org/data/2.5/forecast", p.query(location), &resp); err != nil {
+		return nil, err
+	}
+
+	const readingsPerDay = 8
+	reports := make([]Report, 0, days)
+	for i := 0; i < len(resp.List) && len(reports) < days; i += readingsPerDay {
+		report := toReport(resp.List[i])
+		report.Location = resp.City.Name
+		reports = append(reports, *report)
+	}
+	return reports, nil
+}
+
+func (p *OpenWeatherMapProvider) get(ctx context.Context, endpoint string, v url.Values, out interface{}) error {
+	req, err := http.NewRequestWithContext(ctx, "GET", endpoint+"?"+v.Encode(), nil)
+	if err != nil {
+		return fmt.Errorf("failed to create request: %v", err)
+	}
+
+	resp, err := p.httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to send request: %v", err)
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return fmt.Errorf("failed to read response: %v", err)
+	}
+
+	if err := json.Unmarshal(body, out); err != nil {
+		return fmt.Errorf("failed to unmarshal response: %v", err)
+	}
+	return nil
+}
+
+// toReport converts an OpenWeatherMap response into a Report, converting
+// temperature from Kelvin to Celsius and Fahrenheit and joining the
+// weather[] array's conditions.
+func toReport(resp owmResponse) *Report {
+	tempC := resp.Main.Temp - 273.15
+
+	conditions := make([]string, 0, len(resp.Weather))
+	for _, w := range resp.Weather {
+		conditions = append(conditions, w.Main)
+	}
+
+	report := &Report{
+		Location:   resp.Name,
+		Conditions: strings.Join(conditions, ", "),
+		TempC:      tempC,
+		TempF:      celsiusToFahrenheit(tempC),
+		Humidity:   resp.Main.Humidity,
+		WindSpeed:  resp.Wind.Speed,
+		WindDeg:    resp.Wind.Deg,
+	}
+	if resp.Sys.Sunrise > 0 {
+		report.Sunrise = time.Unix(resp.Sys.Sunrise, 0).Format(time.RFC3339)
+	}
+	if resp.Sys.Sunset > 0 {
+		report.Sunset = time.Unix(resp.Sys.Sunset, 0).Format(time.RFC3339)
+	}
+	if resp.Dt > 0 {
+		report.Date = time.Unix(resp.Dt, 0).Format(time.RFC3339)
+	}
+	return report
+}
@@ -0,0 +1,29 @@
+package weather
+
+import (
+	"fmt"
+	"os"
+)
+
+// NewProviderFromEnv builds a Provider selected by the WEATHER_PROVIDER
+// environment variable ("amap" or "openweathermap"), defaulting to "amap".
+func NewProviderFromEnv() (Provider, error) {
+	name := os.Getenv("WEATHER_PROVIDER")
+	if name == "" {
+		name = "amap"
+	}
+	return NewProvider(name)
+}
+
+// NewProvider builds the named Provider, reading the API key it needs from
+// the environment.
+func NewProvider(name string) (Provider, error) {
+	switch name {
+	case "amap":
+		return NewAMapProvider(os.Getenv("LBS_API_KEY")), nil
+	case "openweathermap":
+		return NewOpenWeatherMapProvider(os.Getenv("OWM_API_KEY")), nil
+	default:
+		return nil, fmt.Errorf("unknown weather provider %q", name)
+	}
+}
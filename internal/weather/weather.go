@@ -0,0 +1,25 @@
+package weather
+
+import "context"
+
+// Report is a snapshot of weather conditions for a location on a given date.
+type Report struct {
+	Location   string
+	Date       string
+	Conditions string
+	TempC      float64
+	TempF      float64
+	Humidity   int
+	WindSpeed  float64
+	WindDeg    int
+	Sunrise    string
+	Sunset     string
+}
+
+// Provider looks up current and forecast weather conditions for a location.
+type Provider interface {
+	// Current returns today's conditions for location.
+	Current(ctx context.Context, location string) (*Report, error)
+	// Forecast returns the next `days` days of conditions for location.
+	Forecast(ctx context.Context, location string, days int) ([]Report, error)
+}
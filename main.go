@@ -0,0 +1,70 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+
+	"github.com/walterfan/lazy-rabbit-agent/internal/agent"
+	"github.com/walterfan/lazy-rabbit-agent/internal/llm"
+	"github.com/walterfan/lazy-rabbit-agent/internal/weather"
+)
+
+func main() {
+	var city string
+	var stream bool
+	var interactive bool
+	var sessionID string
+	flag.StringVar(&city, "city", "Hefei", "City name to get weather for")
+	flag.BoolVar(&stream, "stream", false, "Stream the model's reply to stdout as it's generated")
+	flag.BoolVar(&interactive, "interactive", false, "Start an interactive REPL that maintains conversation history across turns")
+	flag.StringVar(&sessionID, "session", "", "Session id to resume/save under ~/.lazy-rabbit/sessions in -interactive mode; omitted sessions are kept in memory only")
+	flag.Parse()
+
+	client, err := llm.NewClientFromEnv()
+	if err != nil {
+		fmt.Printf("Error configuring LLM client: %v\n", err)
+		os.Exit(1)
+	}
+
+	weatherProvider, err := weather.NewProviderFromEnv()
+	if err != nil {
+		fmt.Printf("Error configuring weather provider: %v\n", err)
+		os.Exit(1)
+	}
+
+	registry := agent.NewToolRegistry()
+	agent.RegisterWeatherTool(registry, weatherProvider)
+	agent.RegisterFetchTool(registry)
+	agent.RegisterShellExecTool(registry)
+
+	a := agent.NewAgent(client, registry)
+
+	if interactive {
+		runREPL(a, stream, sessionID)
+		return
+	}
+
+	messages := []llm.Message{
+		{Role: "user", Content: fmt.Sprintf("How's the weather in %s?", city)},
+	}
+	fmt.Printf("User> %s\n", messages[0].Content)
+
+	if stream {
+		fmt.Print("Model> ")
+		if _, err := a.StreamRun(context.Background(), messages); err != nil {
+			fmt.Printf("Error running agent: %v\n", err)
+			os.Exit(1)
+		}
+		return
+	}
+
+	result, err := a.Run(context.Background(), messages)
+	if err != nil {
+		fmt.Printf("Error running agent: %v\n", err)
+		os.Exit(1)
+	}
+
+	fmt.Printf("Model> %s\n", result[len(result)-1].Content)
+}
@@ -0,0 +1,95 @@
+package main
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"os"
+	"strconv"
+
+	"github.com/walterfan/lazy-rabbit-agent/internal/agent"
+	"github.com/walterfan/lazy-rabbit-agent/internal/llm"
+	"github.com/walterfan/lazy-rabbit-agent/internal/memory"
+)
+
+// defaultMaxContextTokens is used when LLM_MAX_CONTEXT_TOKENS is unset.
+const defaultMaxContextTokens = 8000
+
+// runREPL drives an interactive chat loop against a, maintaining message
+// history in a session so prior turns carry into the next request. Sessions
+// persist across restarts when sessionID is set (file-backed); otherwise
+// they live only in memory for this process. Type "exit" or Ctrl-D to quit.
+func runREPL(a *agent.Agent, stream bool, sessionID string) {
+	store, id := sessionStore(sessionID)
+
+	messages, err := store.Load(id)
+	if err != nil {
+		messages = nil
+	}
+
+	maxTokens := defaultMaxContextTokens
+	if v := os.Getenv("LLM_MAX_CONTEXT_TOKENS"); v != "" {
+		if parsed, err := strconv.Atoi(v); err == nil {
+			maxTokens = parsed
+		}
+	}
+
+	fmt.Printf("lazy-rabbit-agent interactive session %q (type 'exit' to quit)\n", id)
+
+	scanner := bufio.NewScanner(os.Stdin)
+	for {
+		fmt.Print("User> ")
+		if !scanner.Scan() {
+			break
+		}
+		line := scanner.Text()
+		if line == "exit" {
+			break
+		}
+
+		messages = memory.Trim(append(messages, llm.Message{Role: "user", Content: line}), maxTokens)
+
+		var result []llm.Message
+		var runErr error
+		if stream {
+			fmt.Print("Model> ")
+			result, runErr = a.StreamRun(context.Background(), messages)
+		} else {
+			result, runErr = a.Run(context.Background(), messages)
+		}
+		if runErr != nil {
+			fmt.Printf("Error running agent: %v\n", runErr)
+			continue
+		}
+		messages = result
+		if !stream {
+			fmt.Printf("Model> %s\n", messages[len(messages)-1].Content)
+		}
+
+		if err := store.Save(id, messages); err != nil {
+			fmt.Printf("Warning: failed to save session: %v\n", err)
+		}
+	}
+}
+
+// sessionStore picks a file-backed store for an explicit sessionID, so it
+// persists across restarts, or an ephemeral in-memory store when none is given.
+func sessionStore(sessionID string) (memory.Store, string) {
+	if sessionID == "" {
+		return memory.NewInMemoryStore(), "default"
+	}
+
+	dir, err := memory.DefaultDir()
+	if err != nil {
+		fmt.Printf("Warning: %v; falling back to in-memory session storage\n", err)
+		return memory.NewInMemoryStore(), sessionID
+	}
+
+	store, err := memory.NewFileStore(dir)
+	if err != nil {
+		fmt.Printf("Warning: %v; falling back to in-memory session storage\n", err)
+		return memory.NewInMemoryStore(), sessionID
+	}
+
+	return store, sessionID
+}